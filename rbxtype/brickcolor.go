@@ -0,0 +1,164 @@
+package rbxtype
+
+import "math/rand"
+
+// BrickColorInfo describes one entry in the Roblox BrickColor palette: the
+// numeric ID stored in place files, its display name, and its Color3
+// value.
+type BrickColorInfo struct {
+	ID    BrickColor
+	Name  string
+	Color Color3
+}
+
+// palette lists the known BrickColor entries in their canonical order, as
+// returned by BrickColor.Palette. It backs the exported Palette function;
+// to add entries, append to it and call indexPalette to refresh the lookup
+// tables.
+var palette = []BrickColorInfo{
+	{1, "White", Color3{0.949, 0.953, 0.953}},
+	{2, "Grey", Color3{0.631, 0.647, 0.635}},
+	{3, "Light yellow", Color3{0.976, 0.914, 0.600}},
+	{5, "Brick yellow", Color3{0.843, 0.773, 0.600}},
+	{6, "Light green (Mint)", Color3{0.631, 0.769, 0.549}},
+	{9, "Light reddish violet", Color3{0.851, 0.690, 0.718}},
+	{11, "Pastel blue", Color3{0.502, 0.733, 0.859}},
+	{12, "Light orange brown", Color3{0.745, 0.518, 0.239}},
+	{18, "Nougat", Color3{0.800, 0.557, 0.412}},
+	{21, "Bright red", Color3{0.769, 0.157, 0.110}},
+	{22, "Med. reddish violet", Color3{0.816, 0.576, 0.655}},
+	{23, "Bright blue", Color3{0.051, 0.412, 0.675}},
+	{24, "Bright yellow", Color3{0.961, 0.804, 0.188}},
+	{26, "Black", Color3{0.106, 0.165, 0.208}},
+	{27, "Dark grey", Color3{0.424, 0.431, 0.427}},
+	{28, "Dark green", Color3{0.157, 0.498, 0.278}},
+	{29, "Medium green", Color3{0.631, 0.769, 0.549}},
+	{36, "Light yellowish orange", Color3{0.965, 0.843, 0.702}},
+	{37, "Bright green", Color3{0.294, 0.592, 0.294}},
+	{38, "Dark orange", Color3{0.627, 0.373, 0.208}},
+	{39, "Light bluish violet", Color3{0.757, 0.792, 0.871}},
+	{40, "Transparent", Color3{0.925, 0.925, 0.925}},
+	{41, "Tr. Red", Color3{0.804, 0.329, 0.294}},
+	{42, "Tr. Lg blue", Color3{0.757, 0.875, 0.941}},
+	{43, "Tr. Blue", Color3{0.482, 0.714, 0.910}},
+	{44, "Tr. Yellow", Color3{0.969, 0.945, 0.553}},
+	{45, "Light blue", Color3{0.706, 0.824, 0.894}},
+	{100, "Light red", Color3{0.933, 0.769, 0.714}},
+	{101, "Medium red", Color3{0.855, 0.525, 0.478}},
+	{102, "Medium blue", Color3{0.431, 0.600, 0.792}},
+	{103, "Light grey", Color3{0.780, 0.757, 0.718}},
+	{104, "Bright purple", Color3{0.420, 0.196, 0.486}},
+	{105, "Light purple", Color3{0.804, 0.643, 0.871}},
+	{106, "Bright orange", Color3{0.855, 0.522, 0.255}},
+	{107, "Bright bluish green", Color3{0, 0.561, 0.612}},
+	{108, "Earth yellow", Color3{0.408, 0.361, 0.263}},
+	{109, "Bright bluish violet", Color3{0.263, 0.329, 0.576}},
+	{110, "Violet", Color3{0.176, 0.184, 0.380}},
+	{111, "Grey violet", Color3{0.471, 0.427, 0.494}},
+	{112, "Medium bluish violet", Color3{0.431, 0.420, 0.710}},
+	{115, "Med. yellowish green", Color3{0.780, 0.824, 0.235}},
+	{116, "Med. bluish green", Color3{0.333, 0.647, 0.686}},
+	{118, "Light bluish green", Color3{0.706, 0.824, 0.894}},
+	{119, "Br. yellowish green", Color3{0.643, 0.741, 0.278}},
+	{120, "Lig. yellowish green", Color3{0.851, 0.894, 0.655}},
+	{121, "Med. yellowish orange", Color3{0.906, 0.588, 0.235}},
+	{123, "Br. reddish orange", Color3{0.827, 0.435, 0.298}},
+	{124, "Bright reddish violet", Color3{0.573, 0.224, 0.471}},
+	{125, "Light orange", Color3{0.918, 0.722, 0.573}},
+	{127, "Gold", Color3{0.671, 0.514, 0.153}},
+	{128, "Dark nougat", Color3{0.678, 0.424, 0.333}},
+	{131, "Silver", Color3{0.612, 0.639, 0.659}},
+	{135, "Sand blue", Color3{0.439, 0.506, 0.604}},
+	{136, "Sand violet", Color3{0.529, 0.486, 0.565}},
+	{137, "Medium orange", Color3{0.878, 0.596, 0.392}},
+	{138, "Sand yellow", Color3{0.580, 0.529, 0.443}},
+	{140, "Earth blue", Color3{0.125, 0.227, 0.337}},
+	{141, "Earth green", Color3{0.153, 0.275, 0.176}},
+	{151, "Sand green", Color3{0.471, 0.565, 0.510}},
+	{153, "Sand red", Color3{0.584, 0.475, 0.467}},
+	{154, "Dark red", Color3{0.482, 0.180, 0.184}},
+	{192, "Reddish brown", Color3{0.412, 0.251, 0.157}},
+	{194, "Medium stone grey", Color3{0.639, 0.635, 0.643}},
+	{199, "Dark stone grey", Color3{0.388, 0.373, 0.384}},
+	{208, "Light stone grey", Color3{0.898, 0.894, 0.878}},
+	{216, "Rust", Color3{0.561, 0.298, 0.165}},
+	{226, "Cool yellow", Color3{0.992, 0.918, 0.553}},
+	{232, "Cyan", Color3{0.055, 0.612, 0.886}},
+}
+
+var (
+	idByName  = map[string]BrickColor{}
+	nameByID  = map[BrickColor]string{}
+	colorByID = map[BrickColor]Color3{}
+)
+
+func init() {
+	indexPalette()
+}
+
+// indexPalette rebuilds the by-name and by-ID lookup tables from palette.
+// Call it again after appending new entries to palette.
+func indexPalette() {
+	idByName = make(map[string]BrickColor, len(palette))
+	nameByID = make(map[BrickColor]string, len(palette))
+	colorByID = make(map[BrickColor]Color3, len(palette))
+	for _, entry := range palette {
+		idByName[entry.Name] = entry.ID
+		nameByID[entry.ID] = entry.Name
+		colorByID[entry.ID] = entry.Color
+	}
+}
+
+// BrickColorFromName returns the BrickColor with the given display name.
+func BrickColorFromName(name string) (BrickColor, bool) {
+	id, ok := idByName[name]
+	return id, ok
+}
+
+// Palette returns a copy of the known BrickColor entries in their
+// canonical order. It's a copy so that mutating the result can't desync
+// the by-name/by-ID lookup tables backing BrickColorFromName, BrickColor.Name,
+// and BrickColor.Color from the palette they're supposed to reflect.
+func Palette() []BrickColorInfo {
+	return append([]BrickColorInfo(nil), palette...)
+}
+
+// BrickColors returns every known BrickColor ID, in palette order.
+func BrickColors() []BrickColor {
+	ids := make([]BrickColor, len(palette))
+	for i, entry := range palette {
+		ids[i] = entry.ID
+	}
+	return ids
+}
+
+// ColorDistance measures how far apart two colors are when matching an
+// arbitrary Color3 to its nearest BrickColor in BrickColorFromColor3. It
+// defaults to squared Euclidean distance in RGB space; replace it (e.g.
+// with a CIE LAB distance) to change how nearest-match is judged.
+var ColorDistance = func(a, b Color3) float64 {
+	dr := float64(a.R - b.R)
+	dg := float64(a.G - b.G)
+	db := float64(a.B - b.B)
+	return dr*dr + dg*dg + db*db
+}
+
+// BrickColorFromColor3 returns the BrickColor in the palette whose Color is
+// closest to c, as judged by ColorDistance.
+func BrickColorFromColor3(c Color3) BrickColor {
+	best := palette[0]
+	bestDist := ColorDistance(c, best.Color)
+	for _, entry := range palette[1:] {
+		if d := ColorDistance(c, entry.Color); d < bestDist {
+			best, bestDist = entry, d
+		}
+	}
+	return best.ID
+}
+
+// Random returns a BrickColor drawn uniformly from the palette, using seed
+// to make the choice reproducible.
+func Random(seed int64) BrickColor {
+	i := rand.New(rand.NewSource(seed)).Intn(len(palette))
+	return palette[i].ID
+}
@@ -0,0 +1,66 @@
+package rbxtype
+
+import "testing"
+
+func TestBrickColorFromName(t *testing.T) {
+	id, ok := BrickColorFromName("Bright red")
+	if !ok || id != 21 {
+		t.Errorf("BrickColorFromName(%q) = (%v, %v), want (21, true)", "Bright red", id, ok)
+	}
+	if _, ok := BrickColorFromName("Not a real color"); ok {
+		t.Error("BrickColorFromName of an unknown name should report ok=false")
+	}
+}
+
+func TestBrickColorNameAndColor(t *testing.T) {
+	bc := BrickColor(21)
+	if bc.Name() != "Bright red" {
+		t.Errorf("Name() = %q, want %q", bc.Name(), "Bright red")
+	}
+	if bc.Color() != (Color3{0.769, 0.157, 0.110}) {
+		t.Errorf("Color() = %+v", bc.Color())
+	}
+	if BrickColor(99999).Name() != nameByID[194] {
+		t.Error("Name() of an unknown ID should fall back to Medium stone grey")
+	}
+}
+
+func TestBrickColors(t *testing.T) {
+	ids := BrickColors()
+	entries := Palette()
+	if len(ids) != len(entries) {
+		t.Fatalf("BrickColors() returned %d ids, want %d", len(ids), len(entries))
+	}
+	if ids[0] != entries[0].ID {
+		t.Errorf("BrickColors()[0] = %v, want %v", ids[0], entries[0].ID)
+	}
+}
+
+func TestPaletteIsACopy(t *testing.T) {
+	entries := Palette()
+	entries[0].Color = Color3{R: 1, G: 1, B: 1}
+
+	want := entries[0].ID.Color()
+	if want == (Color3{R: 1, G: 1, B: 1}) {
+		t.Fatal("mutating the slice returned by Palette() affected BrickColor.Color's lookup table")
+	}
+	if got := Palette()[0].Color; got != want {
+		t.Errorf("second call to Palette() = %+v, want unchanged %+v", got, want)
+	}
+}
+
+func TestBrickColorFromColor3(t *testing.T) {
+	want := BrickColor(21)
+	got := BrickColorFromColor3(want.Color())
+	if got != want {
+		t.Errorf("BrickColorFromColor3(exact match) = %v, want %v", got, want)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	a := Random(1)
+	b := Random(1)
+	if a != b {
+		t.Errorf("Random(1) should be reproducible, got %v and %v", a, b)
+	}
+}
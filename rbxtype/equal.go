@@ -0,0 +1,305 @@
+package rbxtype
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"io"
+	"math"
+)
+
+// Equal reports whether a and b hold the same concrete type and value. For
+// floating-point fields the comparison is bit-exact: NaN never equals
+// NaN, and -0 never equals 0. Use EqualApprox to compare geometric values
+// within a tolerance instead.
+func Equal(a, b Type) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	switch av := a.(type) {
+	case String:
+		bv, ok := b.(String)
+		return ok && bytes.Equal(av, bv)
+	case BinaryString:
+		bv, ok := b.(BinaryString)
+		return ok && bytes.Equal(av, bv)
+	case ProtectedString:
+		bv, ok := b.(ProtectedString)
+		return ok && bytes.Equal(av, bv)
+	case Content:
+		bv, ok := b.(Content)
+		return ok && bytes.Equal(av, bv)
+	case Bool:
+		bv, ok := b.(Bool)
+		return ok && av == bv
+	case Int:
+		bv, ok := b.(Int)
+		return ok && av == bv
+	case Float:
+		bv, ok := b.(Float)
+		return ok && float32Equal(float32(av), float32(bv))
+	case Double:
+		bv, ok := b.(Double)
+		return ok && float64Equal(float64(av), float64(bv))
+	case UDim:
+		bv, ok := b.(UDim)
+		return ok && float32Equal(av.Scale, bv.Scale) && av.Offset == bv.Offset
+	case UDim2:
+		bv, ok := b.(UDim2)
+		return ok && Equal(av.X, bv.X) && Equal(av.Y, bv.Y)
+	case Ray:
+		bv, ok := b.(Ray)
+		return ok && Equal(av.Origin, bv.Origin) && Equal(av.Direction, bv.Direction)
+	case Faces:
+		bv, ok := b.(Faces)
+		return ok && av == bv
+	case Axes:
+		bv, ok := b.(Axes)
+		return ok && av == bv
+	case BrickColor:
+		bv, ok := b.(BrickColor)
+		return ok && av == bv
+	case Color3:
+		bv, ok := b.(Color3)
+		return ok && float32Equal(av.R, bv.R) && float32Equal(av.G, bv.G) && float32Equal(av.B, bv.B)
+	case Vector2:
+		bv, ok := b.(Vector2)
+		return ok && float32Equal(av.X, bv.X) && float32Equal(av.Y, bv.Y)
+	case Vector3:
+		bv, ok := b.(Vector3)
+		return ok && float32Equal(av.X, bv.X) && float32Equal(av.Y, bv.Y) && float32Equal(av.Z, bv.Z)
+	case CFrame:
+		bv, ok := b.(CFrame)
+		if !ok || !float32Equal(av.X, bv.X) || !float32Equal(av.Y, bv.Y) || !float32Equal(av.Z, bv.Z) {
+			return false
+		}
+		for i := range av.R {
+			if !float32Equal(av.R[i], bv.R[i]) {
+				return false
+			}
+		}
+		return true
+	case Token:
+		bv, ok := b.(Token)
+		return ok && av == bv
+	case Reference:
+		bv, ok := b.(Reference)
+		return ok && av == bv
+	case Vector3int16:
+		bv, ok := b.(Vector3int16)
+		return ok && av == bv
+	case Vector2int16:
+		bv, ok := b.(Vector2int16)
+		return ok && av == bv
+	case Region3:
+		bv, ok := b.(Region3)
+		return ok && Equal(av.CFrame, bv.CFrame) && Equal(av.Size, bv.Size)
+	case Region3int16:
+		bv, ok := b.(Region3int16)
+		return ok && av == bv
+	default:
+		return false
+	}
+}
+
+// EqualApprox is like Equal, but compares floating-point fields within
+// tolerance eps instead of requiring a bit-exact match. Types with no
+// floating-point fields fall back to Equal.
+func EqualApprox(a, b Type, eps float32) bool {
+	switch av := a.(type) {
+	case Float:
+		bv, ok := b.(Float)
+		return ok && approx(float32(av), float32(bv), eps)
+	case Double:
+		bv, ok := b.(Double)
+		return ok && approx64(float64(av), float64(bv), float64(eps))
+	case UDim:
+		bv, ok := b.(UDim)
+		return ok && approx(av.Scale, bv.Scale, eps) && av.Offset == bv.Offset
+	case UDim2:
+		bv, ok := b.(UDim2)
+		return ok && EqualApprox(av.X, bv.X, eps) && EqualApprox(av.Y, bv.Y, eps)
+	case Ray:
+		bv, ok := b.(Ray)
+		return ok && EqualApprox(av.Origin, bv.Origin, eps) && EqualApprox(av.Direction, bv.Direction, eps)
+	case Color3:
+		bv, ok := b.(Color3)
+		return ok && approx(av.R, bv.R, eps) && approx(av.G, bv.G, eps) && approx(av.B, bv.B, eps)
+	case Vector2:
+		bv, ok := b.(Vector2)
+		return ok && approx(av.X, bv.X, eps) && approx(av.Y, bv.Y, eps)
+	case Vector3:
+		bv, ok := b.(Vector3)
+		return ok && approx(av.X, bv.X, eps) && approx(av.Y, bv.Y, eps) && approx(av.Z, bv.Z, eps)
+	case CFrame:
+		bv, ok := b.(CFrame)
+		if !ok || !approx(av.X, bv.X, eps) || !approx(av.Y, bv.Y, eps) || !approx(av.Z, bv.Z, eps) {
+			return false
+		}
+		for i := range av.R {
+			if !approx(av.R[i], bv.R[i], eps) {
+				return false
+			}
+		}
+		return true
+	case Region3:
+		bv, ok := b.(Region3)
+		return ok && EqualApprox(av.CFrame, bv.CFrame, eps) && EqualApprox(av.Size, bv.Size, eps)
+	default:
+		return Equal(a, b)
+	}
+}
+
+func float32Equal(a, b float32) bool {
+	if math.IsNaN(float64(a)) || math.IsNaN(float64(b)) {
+		return false
+	}
+	return math.Float32bits(a) == math.Float32bits(b)
+}
+
+func float64Equal(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+	return math.Float64bits(a) == math.Float64bits(b)
+}
+
+func approx(a, b, eps float32) bool {
+	if math.IsNaN(float64(a)) || math.IsNaN(float64(b)) {
+		return false
+	}
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return d <= eps
+}
+
+// approx64 is approx for float64 operands, used by EqualApprox's Double
+// case so double precision isn't discarded by rounding both values down
+// to float32 before comparing.
+func approx64(a, b, eps float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	return d <= eps
+}
+
+// Hash returns a fixed-seed hash of t's value, chosen to mirror Equal:
+// values that compare equal always hash equal.
+func Hash(t Type) uint64 {
+	h := fnv.New64a()
+	writeHash(h, t)
+	return h.Sum64()
+}
+
+func writeHash(h hash.Hash64, t Type) {
+	io.WriteString(h, t.TypeString())
+	h.Write([]byte{0})
+
+	switch v := t.(type) {
+	case String:
+		h.Write(v)
+	case BinaryString:
+		h.Write(v)
+	case ProtectedString:
+		h.Write(v)
+	case Content:
+		h.Write(v)
+	case Bool:
+		writeBool(h, bool(v))
+	case Int:
+		writeUint32(h, uint32(v))
+	case Float:
+		writeFloat32(h, float32(v))
+	case Double:
+		writeFloat64(h, float64(v))
+	case UDim:
+		writeFloat32(h, v.Scale)
+		writeUint32(h, uint32(v.Offset))
+	case UDim2:
+		writeHash(h, v.X)
+		writeHash(h, v.Y)
+	case Ray:
+		writeHash(h, v.Origin)
+		writeHash(h, v.Direction)
+	case Faces:
+		writeBool(h, v.Right)
+		writeBool(h, v.Top)
+		writeBool(h, v.Back)
+		writeBool(h, v.Left)
+		writeBool(h, v.Bottom)
+		writeBool(h, v.Front)
+	case Axes:
+		writeBool(h, v.X)
+		writeBool(h, v.Y)
+		writeBool(h, v.Z)
+	case BrickColor:
+		writeUint32(h, uint32(v))
+	case Color3:
+		writeFloat32(h, v.R)
+		writeFloat32(h, v.G)
+		writeFloat32(h, v.B)
+	case Vector2:
+		writeFloat32(h, v.X)
+		writeFloat32(h, v.Y)
+	case Vector3:
+		writeFloat32(h, v.X)
+		writeFloat32(h, v.Y)
+		writeFloat32(h, v.Z)
+	case CFrame:
+		writeFloat32(h, v.X)
+		writeFloat32(h, v.Y)
+		writeFloat32(h, v.Z)
+		for _, f := range v.R {
+			writeFloat32(h, f)
+		}
+	case Token:
+		writeUint32(h, uint32(v))
+	case Reference:
+		io.WriteString(h, fmt.Sprintf("%p", v.Instance))
+	case Vector3int16:
+		writeUint32(h, uint32(uint16(v.X)))
+		writeUint32(h, uint32(uint16(v.Y)))
+		writeUint32(h, uint32(uint16(v.Z)))
+	case Vector2int16:
+		writeUint32(h, uint32(uint16(v.X)))
+		writeUint32(h, uint32(uint16(v.Y)))
+	case Region3:
+		writeHash(h, v.CFrame)
+		writeHash(h, v.Size)
+	case Region3int16:
+		writeHash(h, v.Min)
+		writeHash(h, v.Max)
+	}
+}
+
+func writeBool(h hash.Hash64, b bool) {
+	if b {
+		h.Write([]byte{1})
+	} else {
+		h.Write([]byte{0})
+	}
+}
+
+func writeUint32(h hash.Hash64, v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	h.Write(b[:])
+}
+
+func writeFloat32(h hash.Hash64, f float32) {
+	writeUint32(h, math.Float32bits(f))
+}
+
+func writeFloat64(h hash.Hash64, f float64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(f))
+	h.Write(b[:])
+}
@@ -0,0 +1,64 @@
+package rbxtype
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	if !Equal(Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 1, Y: 2, Z: 3}) {
+		t.Error("identical Vector3 values should be Equal")
+	}
+	if Equal(Vector3{X: 1}, Vector3{X: 2}) {
+		t.Error("different Vector3 values should not be Equal")
+	}
+	if Equal(Int(1), Float(1)) {
+		t.Error("values of different concrete types should not be Equal")
+	}
+	if Equal(Float(float32(math.NaN())), Float(float32(math.NaN()))) {
+		t.Error("NaN should never equal NaN")
+	}
+	if Equal(Float(0), Float(float32(math.Copysign(0, -1)))) {
+		t.Error("0 should not equal -0 under bit-exact Equal")
+	}
+	if !Equal(nil, nil) {
+		t.Error("nil should equal nil")
+	}
+	if Equal(nil, Int(0)) {
+		t.Error("nil should not equal a non-nil Type")
+	}
+}
+
+func TestEqualApprox(t *testing.T) {
+	a := Vector3{X: 1, Y: 2, Z: 3}
+	b := Vector3{X: 1.0001, Y: 2, Z: 3}
+	if EqualApprox(a, b, 1e-6) {
+		t.Error("values outside eps should not be EqualApprox")
+	}
+	if !EqualApprox(a, b, 1e-3) {
+		t.Error("values within eps should be EqualApprox")
+	}
+}
+
+func TestEqualApproxDoubleKeepsPrecision(t *testing.T) {
+	x := Double(100000000.123456)
+	y := Double(100000000.123789)
+	if EqualApprox(x, y, 1e-6) {
+		t.Error("EqualApprox should compare Double in float64, not collapse precision through a float32 cast")
+	}
+	if !EqualApprox(x, y, 1e-3) {
+		t.Error("values within eps should be EqualApprox")
+	}
+}
+
+func TestHashMirrorsEqual(t *testing.T) {
+	a := CFrame{X: 1, Y: 2, Z: 3, R: [9]float32{1, 0, 0, 0, 1, 0, 0, 0, 1}}
+	b := a
+	if Hash(a) != Hash(b) {
+		t.Error("equal values should hash equal")
+	}
+	b.X = 5
+	if Hash(a) == Hash(b) {
+		t.Error("different values should (almost always) hash differently")
+	}
+}
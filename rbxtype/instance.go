@@ -0,0 +1,32 @@
+package rbxtype
+
+// Instance is an in-memory Roblox instance: a class name, an optional
+// serialized referent, a bag of properties, and child instances. It is the
+// type that Reference points to, and the unit that packages like rbxxml
+// encode and decode.
+type Instance struct {
+	ClassName string
+	Reference string
+
+	// IsService marks an instance as a top-level service (e.g. Workspace,
+	// Lighting), as recorded by an Item's "service" attribute in rbxxml.
+	IsService bool
+
+	Properties map[string]Type
+	Children   []*Instance
+}
+
+// Name returns the instance's Name property, or its ClassName if the
+// instance has no Name property set.
+func (inst *Instance) Name() string {
+	if name, ok := inst.Properties["Name"].(String); ok {
+		return string(name)
+	}
+	return inst.ClassName
+}
+
+// Root is the top level of a Roblox place or model: a flat list of
+// instances with no shared parent.
+type Root struct {
+	Instances []*Instance
+}
@@ -0,0 +1,436 @@
+package rbxtype
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// typeEnvelope is the wire representation of every rbxtype.Type value:
+// {"type": TypeString(), "value": <type-specific payload>}.
+type typeEnvelope struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// typeRegistry maps a TypeString to a constructor for its zero value, used
+// by UnmarshalType to recover the concrete type behind a tagged envelope.
+var typeRegistry = map[string]func() Type{}
+
+// RegisterType associates name, as returned by a Type's TypeString, with a
+// constructor for a new zero value of that type. zero must return a
+// pointer to that value (e.g. new(Vector3)) that implements
+// json.Unmarshaler; UnmarshalType decodes into it and returns the value it
+// points to. Downstream packages can
+// call this to make their own Type implementations decodable by
+// UnmarshalType.
+func RegisterType(name string, zero func() Type) {
+	typeRegistry[name] = zero
+}
+
+// UnmarshalType decodes a tagged {"type":...,"value":...} envelope into the
+// concrete Type registered under its "type" field.
+func UnmarshalType(data []byte) (Type, error) {
+	var env typeEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+	zero, ok := typeRegistry[env.Type]
+	if !ok {
+		return nil, fmt.Errorf("rbxtype: %w: %q", ErrUnknownType, env.Type)
+	}
+	value := zero()
+	u, ok := value.(json.Unmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("rbxtype: type %q does not implement json.Unmarshaler", env.Type)
+	}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	// zero returns a pointer (e.g. *Vector3) so it can satisfy
+	// json.Unmarshaler; dereference it so callers get the value type that
+	// appears in Type type switches (e.g. `case Vector3:`) elsewhere in
+	// this package.
+	return reflect.ValueOf(value).Elem().Interface().(Type), nil
+}
+
+func init() {
+	RegisterType("string", func() Type { return new(String) })
+	RegisterType("BinaryString", func() Type { return new(BinaryString) })
+	RegisterType("ProtectedString", func() Type { return new(ProtectedString) })
+	RegisterType("Content", func() Type { return new(Content) })
+	RegisterType("bool", func() Type { return new(Bool) })
+	RegisterType("int", func() Type { return new(Int) })
+	RegisterType("float", func() Type { return new(Float) })
+	RegisterType("double", func() Type { return new(Double) })
+	RegisterType("UDim", func() Type { return new(UDim) })
+	RegisterType("UDim2", func() Type { return new(UDim2) })
+	RegisterType("Ray", func() Type { return new(Ray) })
+	RegisterType("Faces", func() Type { return new(Faces) })
+	RegisterType("Axes", func() Type { return new(Axes) })
+	RegisterType("BrickColor", func() Type { return new(BrickColor) })
+	RegisterType("Color3", func() Type { return new(Color3) })
+	RegisterType("Vector2", func() Type { return new(Vector2) })
+	RegisterType("Vector3", func() Type { return new(Vector3) })
+	RegisterType("CoordinateFrame", func() Type { return new(CFrame) })
+	RegisterType("token", func() Type { return new(Token) })
+	RegisterType("Ref", func() Type { return new(Reference) })
+	RegisterType("Vector3int16", func() Type { return new(Vector3int16) })
+	RegisterType("Vector2int16", func() Type { return new(Vector2int16) })
+	RegisterType("Region3", func() Type { return new(Region3) })
+	RegisterType("Region3int16", func() Type { return new(Region3int16) })
+}
+
+// marshalTyped wraps value, marshaled on its own, in a typeEnvelope tagged
+// with typeName.
+func marshalTyped(typeName string, value interface{}) ([]byte, error) {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(typeEnvelope{Type: typeName, Value: v})
+}
+
+// unmarshalTyped unwraps a typeEnvelope and decodes its value into out.
+func unmarshalTyped(data []byte, out interface{}) error {
+	var env typeEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return err
+	}
+	return json.Unmarshal(env.Value, out)
+}
+
+////////////////////////////////////////////////////////////////
+// Marshaler / Unmarshaler
+
+// String
+
+func (t String) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), string(t))
+}
+func (t *String) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := unmarshalTyped(data, &s); err != nil {
+		return err
+	}
+	*t = String(s)
+	return nil
+}
+
+////////////////
+
+func (t BinaryString) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), []byte(t))
+}
+func (t *BinaryString) UnmarshalJSON(data []byte) error {
+	var b []byte
+	if err := unmarshalTyped(data, &b); err != nil {
+		return err
+	}
+	*t = BinaryString(b)
+	return nil
+}
+
+////////////////
+
+func (t ProtectedString) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), string(t))
+}
+func (t *ProtectedString) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := unmarshalTyped(data, &s); err != nil {
+		return err
+	}
+	*t = ProtectedString(s)
+	return nil
+}
+
+////////////////
+
+func (t Content) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), string(t))
+}
+func (t *Content) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := unmarshalTyped(data, &s); err != nil {
+		return err
+	}
+	*t = Content(s)
+	return nil
+}
+
+////////////////
+
+func (t Bool) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), bool(t))
+}
+func (t *Bool) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := unmarshalTyped(data, &b); err != nil {
+		return err
+	}
+	*t = Bool(b)
+	return nil
+}
+
+////////////////
+
+func (t Int) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), int32(t))
+}
+func (t *Int) UnmarshalJSON(data []byte) error {
+	var i int32
+	if err := unmarshalTyped(data, &i); err != nil {
+		return err
+	}
+	*t = Int(i)
+	return nil
+}
+
+////////////////
+
+func (t Float) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), float32(t))
+}
+func (t *Float) UnmarshalJSON(data []byte) error {
+	var f float32
+	if err := unmarshalTyped(data, &f); err != nil {
+		return err
+	}
+	*t = Float(f)
+	return nil
+}
+
+////////////////
+
+func (t Double) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), float64(t))
+}
+func (t *Double) UnmarshalJSON(data []byte) error {
+	var f float64
+	if err := unmarshalTyped(data, &f); err != nil {
+		return err
+	}
+	*t = Double(f)
+	return nil
+}
+
+////////////////
+
+// udimJSON mirrors UDim's fields without inheriting its MarshalJSON, so
+// marshalTyped/unmarshalTyped can encode the plain struct instead of
+// recursing into UDim's own methods.
+type udimJSON UDim
+
+func (t UDim) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), udimJSON(t))
+}
+func (t *UDim) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*udimJSON)(t))
+}
+
+////////////////
+
+// udim2JSON holds UDim2's fields as plain (alias-typed) values, so encoding
+// it does not recurse back into UDim's or UDim2's own JSON methods.
+type udim2JSON struct {
+	X, Y udimJSON
+}
+
+func (t UDim2) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), udim2JSON{X: udimJSON(t.X), Y: udimJSON(t.Y)})
+}
+func (t *UDim2) UnmarshalJSON(data []byte) error {
+	var j udim2JSON
+	if err := unmarshalTyped(data, &j); err != nil {
+		return err
+	}
+	t.X, t.Y = UDim(j.X), UDim(j.Y)
+	return nil
+}
+
+////////////////
+
+type rayJSON struct {
+	Origin, Direction vector3JSON
+}
+
+func (t Ray) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), rayJSON{Origin: vector3JSON(t.Origin), Direction: vector3JSON(t.Direction)})
+}
+func (t *Ray) UnmarshalJSON(data []byte) error {
+	var j rayJSON
+	if err := unmarshalTyped(data, &j); err != nil {
+		return err
+	}
+	t.Origin, t.Direction = Vector3(j.Origin), Vector3(j.Direction)
+	return nil
+}
+
+////////////////
+
+type facesJSON Faces
+
+func (t Faces) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), facesJSON(t))
+}
+func (t *Faces) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*facesJSON)(t))
+}
+
+////////////////
+
+type axesJSON Axes
+
+func (t Axes) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), axesJSON(t))
+}
+func (t *Axes) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*axesJSON)(t))
+}
+
+////////////////
+
+func (t BrickColor) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), uint32(t))
+}
+func (t *BrickColor) UnmarshalJSON(data []byte) error {
+	var u uint32
+	if err := unmarshalTyped(data, &u); err != nil {
+		return err
+	}
+	*t = BrickColor(u)
+	return nil
+}
+
+////////////////
+
+type color3JSON Color3
+
+func (t Color3) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), color3JSON(t))
+}
+func (t *Color3) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*color3JSON)(t))
+}
+
+////////////////
+
+type vector2JSON Vector2
+
+func (t Vector2) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), vector2JSON(t))
+}
+func (t *Vector2) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*vector2JSON)(t))
+}
+
+////////////////
+
+type vector3JSON Vector3
+
+func (t Vector3) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), vector3JSON(t))
+}
+func (t *Vector3) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*vector3JSON)(t))
+}
+
+////////////////
+
+type cframeJSON CFrame
+
+func (t CFrame) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), cframeJSON(t))
+}
+func (t *CFrame) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*cframeJSON)(t))
+}
+
+////////////////
+
+func (t Token) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), int32(t))
+}
+func (t *Token) UnmarshalJSON(data []byte) error {
+	var i int32
+	if err := unmarshalTyped(data, &i); err != nil {
+		return err
+	}
+	*t = Token(i)
+	return nil
+}
+
+////////////////
+
+// MarshalJSON encodes the reference as a null value; a pointer to another
+// instance cannot be recovered from JSON alone without an accompanying
+// instance table.
+func (t Reference) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), nil)
+}
+
+// UnmarshalJSON always clears the reference to nil. See MarshalJSON.
+func (t *Reference) UnmarshalJSON(data []byte) error {
+	*t = Reference{}
+	return nil
+}
+
+////////////////
+
+type vector3int16JSON Vector3int16
+
+func (t Vector3int16) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), vector3int16JSON(t))
+}
+func (t *Vector3int16) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*vector3int16JSON)(t))
+}
+
+////////////////
+
+type vector2int16JSON Vector2int16
+
+func (t Vector2int16) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), vector2int16JSON(t))
+}
+func (t *Vector2int16) UnmarshalJSON(data []byte) error {
+	return unmarshalTyped(data, (*vector2int16JSON)(t))
+}
+
+////////////////
+
+type region3JSON struct {
+	CFrame cframeJSON
+	Size   vector3JSON
+}
+
+func (t Region3) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), region3JSON{CFrame: cframeJSON(t.CFrame), Size: vector3JSON(t.Size)})
+}
+func (t *Region3) UnmarshalJSON(data []byte) error {
+	var j region3JSON
+	if err := unmarshalTyped(data, &j); err != nil {
+		return err
+	}
+	t.CFrame, t.Size = CFrame(j.CFrame), Vector3(j.Size)
+	return nil
+}
+
+////////////////
+
+type region3int16JSON struct {
+	Max, Min vector3int16JSON
+}
+
+func (t Region3int16) MarshalJSON() ([]byte, error) {
+	return marshalTyped(t.TypeString(), region3int16JSON{Max: vector3int16JSON(t.Max), Min: vector3int16JSON(t.Min)})
+}
+func (t *Region3int16) UnmarshalJSON(data []byte) error {
+	var j region3int16JSON
+	if err := unmarshalTyped(data, &j); err != nil {
+		return err
+	}
+	t.Max, t.Min = Vector3int16(j.Max), Vector3int16(j.Min)
+	return nil
+}
@@ -0,0 +1,70 @@
+package rbxtype
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	tests := []Type{
+		String("hello"),
+		ProtectedString("print(\"hi\")"),
+		Content("rbxassetid://1234"),
+		Bool(true),
+		Int(42),
+		Float(1.5),
+		Double(2.25),
+		UDim{Scale: 0.5, Offset: 10},
+		UDim2{X: UDim{Scale: 0.5, Offset: 10}, Y: UDim{Scale: 1, Offset: -5}},
+		Ray{Origin: Vector3{X: 1}, Direction: Vector3{Z: -1}},
+		Faces{Top: true, Front: true},
+		Axes{X: true},
+		BrickColor(194),
+		Color3{R: 1, G: 0.5, B: 0},
+		Vector2{X: 1, Y: 2},
+		Vector3{X: 1, Y: 2, Z: 3},
+		CFrame{X: 1, Y: 2, Z: 3, R: [9]float32{1, 0, 0, 0, 1, 0, 0, 0, 1}},
+		Token(7),
+		Vector3int16{X: 1, Y: 2, Z: 3},
+		Vector2int16{X: 1, Y: 2},
+		Region3{CFrame: CFrame{R: [9]float32{1, 0, 0, 0, 1, 0, 0, 0, 1}}, Size: Vector3{X: 4, Y: 4, Z: 4}},
+		Region3int16{Min: Vector3int16{X: -1}, Max: Vector3int16{X: 1}},
+	}
+
+	for _, want := range tests {
+		t.Run(want.TypeString(), func(t *testing.T) {
+			data, err := json.Marshal(want)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			got, err := UnmarshalType(data)
+			if err != nil {
+				t.Fatalf("UnmarshalType: %v", err)
+			}
+			if !Equal(got, want) {
+				t.Errorf("UnmarshalType(Marshal(%v)) = %v, want %v", want, got, want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTypeUnknownType(t *testing.T) {
+	_, err := UnmarshalType([]byte(`{"type":"NotAType","value":null}`))
+	if err == nil {
+		t.Fatal("UnmarshalType with an unregistered type should return an error")
+	}
+}
+
+func TestReferenceJSON(t *testing.T) {
+	data, err := json.Marshal(Reference{})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	got, err := UnmarshalType(data)
+	if err != nil {
+		t.Fatalf("UnmarshalType: %v", err)
+	}
+	if _, ok := got.(Reference); !ok {
+		t.Fatalf("UnmarshalType returned %T, want Reference", got)
+	}
+}
@@ -0,0 +1,277 @@
+package rbxtype
+
+import (
+	"strconv"
+	"strings"
+)
+
+// luaQuote returns s as a double-quoted Luau string literal, with
+// backslashes, quotes, newlines, tabs, and other non-printable bytes
+// escaped.
+func luaQuote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"':
+			b.WriteString(`\"`)
+		case c == '\\':
+			b.WriteString(`\\`)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\t':
+			b.WriteString(`\t`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		case c < 0x20 || c >= 0x7F:
+			b.WriteString(`\x`)
+			b.WriteString(hexByte(c))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// luaQuoteBytes returns b as a double-quoted Luau string literal with every
+// byte rendered as a \xNN escape, for data that is not meant to be read as
+// text.
+func luaQuoteBytes(b []byte) string {
+	var s strings.Builder
+	s.WriteByte('"')
+	for _, c := range b {
+		s.WriteString(`\x`)
+		s.WriteString(hexByte(c))
+	}
+	s.WriteByte('"')
+	return s.String()
+}
+
+const hexDigits = "0123456789ABCDEF"
+
+func hexByte(c byte) string {
+	return string([]byte{hexDigits[c>>4], hexDigits[c&0xF]})
+}
+
+func luaFloat32(f float32) string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+}
+
+func luaFloat64(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func luaArgs(args ...string) string {
+	return strings.Join(args, ", ")
+}
+
+func luaVector3Args(v Vector3) string {
+	return luaArgs(luaFloat32(v.X), luaFloat32(v.Y), luaFloat32(v.Z))
+}
+
+////////////////////////////////////////////////////////////////
+// LuaString
+
+// String
+
+func (t String) LuaString() string {
+	return luaQuote(string(t))
+}
+
+////////////////
+
+func (t BinaryString) LuaString() string {
+	return luaQuoteBytes(t)
+}
+
+////////////////
+
+func (t ProtectedString) LuaString() string {
+	return luaQuote(string(t))
+}
+
+////////////////
+
+func (t Content) LuaString() string {
+	return luaQuote(string(t))
+}
+
+////////////////
+
+func (t Bool) LuaString() string {
+	return t.String()
+}
+
+////////////////
+
+func (t Int) LuaString() string {
+	return t.String()
+}
+
+////////////////
+
+func (t Float) LuaString() string {
+	return luaFloat32(float32(t))
+}
+
+////////////////
+
+func (t Double) LuaString() string {
+	return luaFloat64(float64(t))
+}
+
+////////////////
+
+func (t UDim) LuaString() string {
+	return "UDim.new(" + luaArgs(luaFloat32(t.Scale), strconv.FormatInt(int64(t.Offset), 10)) + ")"
+}
+
+////////////////
+
+func (t UDim2) LuaString() string {
+	return "UDim2.new(" + luaArgs(
+		luaFloat32(t.X.Scale), strconv.FormatInt(int64(t.X.Offset), 10),
+		luaFloat32(t.Y.Scale), strconv.FormatInt(int64(t.Y.Offset), 10),
+	) + ")"
+}
+
+////////////////
+
+func (t Ray) LuaString() string {
+	return "Ray.new(Vector3.new(" + luaVector3Args(t.Origin) + "), Vector3.new(" + luaVector3Args(t.Direction) + "))"
+}
+
+////////////////
+
+func (t Faces) LuaString() string {
+	var faces []string
+	if t.Right {
+		faces = append(faces, "Enum.NormalId.Right")
+	}
+	if t.Top {
+		faces = append(faces, "Enum.NormalId.Top")
+	}
+	if t.Back {
+		faces = append(faces, "Enum.NormalId.Back")
+	}
+	if t.Left {
+		faces = append(faces, "Enum.NormalId.Left")
+	}
+	if t.Bottom {
+		faces = append(faces, "Enum.NormalId.Bottom")
+	}
+	if t.Front {
+		faces = append(faces, "Enum.NormalId.Front")
+	}
+	return "Faces.new(" + strings.Join(faces, ", ") + ")"
+}
+
+////////////////
+
+func (t Axes) LuaString() string {
+	var axes []string
+	if t.X {
+		axes = append(axes, "Enum.Axis.X")
+	}
+	if t.Y {
+		axes = append(axes, "Enum.Axis.Y")
+	}
+	if t.Z {
+		axes = append(axes, "Enum.Axis.Z")
+	}
+	return "Axes.new(" + strings.Join(axes, ", ") + ")"
+}
+
+////////////////
+
+func (t BrickColor) LuaString() string {
+	return "BrickColor.new(" + strconv.FormatUint(uint64(t), 10) + ")"
+}
+
+////////////////
+
+func (t Color3) LuaString() string {
+	return "Color3.new(" + luaArgs(luaFloat32(t.R), luaFloat32(t.G), luaFloat32(t.B)) + ")"
+}
+
+////////////////
+
+func (t Vector2) LuaString() string {
+	return "Vector2.new(" + luaArgs(luaFloat32(t.X), luaFloat32(t.Y)) + ")"
+}
+
+////////////////
+
+func (t Vector3) LuaString() string {
+	return "Vector3.new(" + luaVector3Args(t) + ")"
+}
+
+////////////////
+
+func (t CFrame) LuaString() string {
+	args := make([]string, 0, 12)
+	args = append(args, luaFloat32(t.X), luaFloat32(t.Y), luaFloat32(t.Z))
+	for _, f := range t.R {
+		args = append(args, luaFloat32(f))
+	}
+	return "CFrame.new(" + luaArgs(args...) + ")"
+}
+
+////////////////
+
+func (t Token) LuaString() string {
+	return t.String()
+}
+
+////////////////
+
+// LuaString returns "nil", since a reference to another instance cannot be
+// expressed as a standalone Luau literal.
+func (t Reference) LuaString() string {
+	return "nil"
+}
+
+////////////////
+
+func (t Vector3int16) LuaString() string {
+	return "Vector3int16.new(" + luaArgs(
+		strconv.FormatInt(int64(t.X), 10),
+		strconv.FormatInt(int64(t.Y), 10),
+		strconv.FormatInt(int64(t.Z), 10),
+	) + ")"
+}
+
+////////////////
+
+func (t Vector2int16) LuaString() string {
+	return "Vector2int16.new(" + luaArgs(
+		strconv.FormatInt(int64(t.X), 10),
+		strconv.FormatInt(int64(t.Y), 10),
+	) + ")"
+}
+
+////////////////
+
+// LuaString renders the region as Region3.new(min, max), computing the
+// axis-aligned corners from the region's center and size.
+func (t Region3) LuaString() string {
+	min := Vector3{
+		X: t.CFrame.X - t.Size.X/2,
+		Y: t.CFrame.Y - t.Size.Y/2,
+		Z: t.CFrame.Z - t.Size.Z/2,
+	}
+	max := Vector3{
+		X: t.CFrame.X + t.Size.X/2,
+		Y: t.CFrame.Y + t.Size.Y/2,
+		Z: t.CFrame.Z + t.Size.Z/2,
+	}
+	return "Region3.new(Vector3.new(" + luaVector3Args(min) + "), Vector3.new(" + luaVector3Args(max) + "))"
+}
+
+////////////////
+
+func (t Region3int16) LuaString() string {
+	return "Region3int16.new(" + t.Min.LuaString() + ", " + t.Max.LuaString() + ")"
+}
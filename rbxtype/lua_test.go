@@ -0,0 +1,31 @@
+package rbxtype
+
+import "testing"
+
+func TestLuaString(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Type
+		want string
+	}{
+		{"String", String("hi\n\"there\""), `"hi\n\"there\""`},
+		{"BinaryString", BinaryString([]byte{0, 1, 0xFF}), `"\x00\x01\xFF"`},
+		{"ProtectedString", ProtectedString("print(\"hi\")"), `"print(\"hi\")"`},
+		{"Content", Content("rbxassetid://1234"), `"rbxassetid://1234"`},
+		{"Bool", Bool(true), "true"},
+		{"Int", Int(-3), "-3"},
+		{"Float", Float(1.5), "1.5"},
+		{"UDim", UDim{Scale: 0.5, Offset: 10}, "UDim.new(0.5, 10)"},
+		{"Vector3", Vector3{X: 1, Y: 2, Z: 3}, "Vector3.new(1, 2, 3)"},
+		{"Color3", Color3{R: 1, G: 0, B: 0.5}, "Color3.new(1, 0, 0.5)"},
+		{"BrickColor", BrickColor(21), "BrickColor.new(21)"},
+		{"Reference", Reference{}, "nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.(interface{ LuaString() string }).LuaString(); got != tt.want {
+				t.Errorf("LuaString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
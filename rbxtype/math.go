@@ -0,0 +1,224 @@
+package rbxtype
+
+import "math"
+
+////////////////////////////////////////////////////////////////
+// Vector3
+
+// Add returns the sum of t and o.
+func (t Vector3) Add(o Vector3) Vector3 {
+	return Vector3{X: t.X + o.X, Y: t.Y + o.Y, Z: t.Z + o.Z}
+}
+
+// Sub returns t minus o.
+func (t Vector3) Sub(o Vector3) Vector3 {
+	return Vector3{X: t.X - o.X, Y: t.Y - o.Y, Z: t.Z - o.Z}
+}
+
+// Mul returns t scaled by s.
+func (t Vector3) Mul(s float32) Vector3 {
+	return Vector3{X: t.X * s, Y: t.Y * s, Z: t.Z * s}
+}
+
+// Dot returns the dot product of t and o.
+func (t Vector3) Dot(o Vector3) float32 {
+	return t.X*o.X + t.Y*o.Y + t.Z*o.Z
+}
+
+// Cross returns the cross product of t and o.
+func (t Vector3) Cross(o Vector3) Vector3 {
+	return Vector3{
+		X: t.Y*o.Z - t.Z*o.Y,
+		Y: t.Z*o.X - t.X*o.Z,
+		Z: t.X*o.Y - t.Y*o.X,
+	}
+}
+
+// Magnitude returns the length of t.
+func (t Vector3) Magnitude() float32 {
+	return float32(math.Sqrt(float64(t.Dot(t))))
+}
+
+// Unit returns t scaled to a length of 1. It returns the zero vector if t
+// has zero length.
+func (t Vector3) Unit() Vector3 {
+	m := t.Magnitude()
+	if m == 0 {
+		return Vector3{}
+	}
+	return t.Mul(1 / m)
+}
+
+////////////////////////////////////////////////////////////////
+// CFrame
+//
+// R holds the CFrame's 3x3 rotation matrix in row-major order: R[i*3+j] is
+// the entry at row i, column j. Column vectors are the rotation's X, Y and
+// Z basis vectors, expressed in world space.
+
+func (t CFrame) position() Vector3 {
+	return Vector3{X: t.X, Y: t.Y, Z: t.Z}
+}
+
+func (t CFrame) mulVector(v Vector3) Vector3 {
+	return Vector3{
+		X: t.R[0]*v.X + t.R[1]*v.Y + t.R[2]*v.Z,
+		Y: t.R[3]*v.X + t.R[4]*v.Y + t.R[5]*v.Z,
+		Z: t.R[6]*v.X + t.R[7]*v.Y + t.R[8]*v.Z,
+	}
+}
+
+// Mul returns the composition of t and o: applying the result to a point
+// is equivalent to applying o, then t.
+func (t CFrame) Mul(o CFrame) CFrame {
+	var r [9]float32
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			var sum float32
+			for k := 0; k < 3; k++ {
+				sum += t.R[i*3+k] * o.R[k*3+j]
+			}
+			r[i*3+j] = sum
+		}
+	}
+	pos := t.position().Add(t.mulVector(o.position()))
+	return CFrame{X: pos.X, Y: pos.Y, Z: pos.Z, R: r}
+}
+
+// Inverse returns the inverse of t, assuming t's rotation matrix is
+// orthonormal.
+func (t CFrame) Inverse() CFrame {
+	var r [9]float32
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i*3+j] = t.R[j*3+i]
+		}
+	}
+	inv := CFrame{R: r}
+	pos := inv.mulVector(t.position()).Mul(-1)
+	inv.X, inv.Y, inv.Z = pos.X, pos.Y, pos.Z
+	return inv
+}
+
+// PointToWorldSpace transforms v, given in t's object space, into world
+// space.
+func (t CFrame) PointToWorldSpace(v Vector3) Vector3 {
+	return t.position().Add(t.mulVector(v))
+}
+
+// PointToObjectSpace transforms v, given in world space, into t's object
+// space.
+func (t CFrame) PointToObjectSpace(v Vector3) Vector3 {
+	return t.Inverse().PointToWorldSpace(v)
+}
+
+// LookAt returns a CFrame positioned at eye and oriented so that its -Z
+// axis points toward target, with up used to resolve the remaining
+// rotation about that axis.
+func LookAt(eye, target, up Vector3) CFrame {
+	zAxis := eye.Sub(target).Unit()
+	xAxis := up.Cross(zAxis).Unit()
+	yAxis := zAxis.Cross(xAxis)
+	return CFrame{
+		X: eye.X, Y: eye.Y, Z: eye.Z,
+		R: [9]float32{
+			xAxis.X, yAxis.X, zAxis.X,
+			xAxis.Y, yAxis.Y, zAxis.Y,
+			xAxis.Z, yAxis.Z, zAxis.Z,
+		},
+	}
+}
+
+// FromAxisAngle returns a CFrame at the origin that rotates by angle
+// radians about axis, using the Rodrigues rotation formula.
+func FromAxisAngle(axis Vector3, angle float32) CFrame {
+	a := axis.Unit()
+	s, c := math.Sincos(float64(angle))
+	sin, cos := float32(s), float32(c)
+	t := 1 - cos
+	return CFrame{
+		R: [9]float32{
+			t*a.X*a.X + cos, t*a.X*a.Y - sin*a.Z, t*a.X*a.Z + sin*a.Y,
+			t*a.X*a.Y + sin*a.Z, t*a.Y*a.Y + cos, t*a.Y*a.Z - sin*a.X,
+			t*a.X*a.Z - sin*a.Y, t*a.Y*a.Z + sin*a.X, t*a.Z*a.Z + cos,
+		},
+	}
+}
+
+// FromEulerAnglesXYZ returns a CFrame at the origin equivalent to rotating
+// by x radians about the X axis, then y about the Y axis, then z about the
+// Z axis.
+func FromEulerAnglesXYZ(x, y, z float32) CFrame {
+	rx := FromAxisAngle(Vector3{X: 1}, x)
+	ry := FromAxisAngle(Vector3{Y: 1}, y)
+	rz := FromAxisAngle(Vector3{Z: 1}, z)
+	return rx.Mul(ry).Mul(rz)
+}
+
+// ToEulerAnglesXYZ decomposes t's rotation into angles x, y, z such that
+// FromEulerAnglesXYZ(x, y, z) reproduces it.
+func (t CFrame) ToEulerAnglesXYZ() (x, y, z float32) {
+	// With R = Rx*Ry*Rz, R[2] (row 0, col 2) is sin(y), and the
+	// remaining angles follow from the rest of the top row and the
+	// last column.
+	y64 := math.Asin(clamp(float64(t.R[2]), -1, 1))
+	y = float32(y64)
+
+	if math.Abs(float64(t.R[2])) < 0.99999 {
+		x = float32(math.Atan2(float64(-t.R[5]), float64(t.R[8])))
+		z = float32(math.Atan2(float64(-t.R[1]), float64(t.R[0])))
+	} else {
+		// Gimbal lock: X and Z rotate about the same axis, so only
+		// their sum (or, when y is -pi/2, their difference) is
+		// determined. Attribute all of it to Z.
+		x = 0
+		z = float32(math.Atan2(float64(t.R[3]), float64(t.R[4])))
+	}
+	return x, y, z
+}
+
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+////////////////////////////////////////////////////////////////
+// Ray
+
+// ClosestPoint returns the point on the ray closest to v. The result never
+// lies behind the ray's origin.
+func (t Ray) ClosestPoint(v Vector3) Vector3 {
+	dir := t.Direction
+	lenSq := dir.Dot(dir)
+	if lenSq == 0 {
+		return t.Origin
+	}
+	d := v.Sub(t.Origin).Dot(dir) / lenSq
+	if d < 0 {
+		d = 0
+	}
+	return t.Origin.Add(dir.Mul(d))
+}
+
+////////////////////////////////////////////////////////////////
+// Region3
+
+// Contains reports whether v lies within the region, which is treated as
+// an axis-aligned box centered on t.CFrame's position with size t.Size.
+func (t Region3) Contains(v Vector3) bool {
+	min, max := t.bounds()
+	return v.X >= min.X && v.X <= max.X &&
+		v.Y >= min.Y && v.Y <= max.Y &&
+		v.Z >= min.Z && v.Z <= max.Z
+}
+
+func (t Region3) bounds() (min, max Vector3) {
+	half := t.Size.Mul(0.5)
+	center := t.CFrame.position()
+	return center.Sub(half), center.Add(half)
+}
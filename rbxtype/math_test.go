@@ -0,0 +1,84 @@
+package rbxtype
+
+import (
+	"math"
+	"testing"
+)
+
+func vec3Close(a, b Vector3, eps float32) bool {
+	return EqualApprox(a, b, eps)
+}
+
+func TestVector3Ops(t *testing.T) {
+	a := Vector3{X: 1, Y: 2, Z: 3}
+	b := Vector3{X: 4, Y: -1, Z: 0.5}
+
+	if got := a.Add(b); got != (Vector3{X: 5, Y: 1, Z: 3.5}) {
+		t.Errorf("Add = %+v", got)
+	}
+	if got := a.Sub(b); got != (Vector3{X: -3, Y: 3, Z: 2.5}) {
+		t.Errorf("Sub = %+v", got)
+	}
+	if got := a.Dot(b); got != 4-2+1.5 {
+		t.Errorf("Dot = %v, want %v", got, 4-2+1.5)
+	}
+	unit := Vector3{X: 3, Y: 0, Z: 4}.Unit()
+	if !vec3Close(unit, Vector3{X: 0.6, Y: 0, Z: 0.8}, 1e-6) {
+		t.Errorf("Unit = %+v", unit)
+	}
+	if (Vector3{}).Unit() != (Vector3{}) {
+		t.Errorf("Unit of zero vector should be zero")
+	}
+}
+
+func TestCFrameInverseRoundTrip(t *testing.T) {
+	cf := LookAt(Vector3{X: 5, Y: 2, Z: -3}, Vector3{}, Vector3{Y: 1})
+	p := Vector3{X: 1, Y: 2, Z: 3}
+
+	world := cf.PointToWorldSpace(p)
+	back := cf.PointToObjectSpace(world)
+	if !vec3Close(back, p, 1e-4) {
+		t.Errorf("PointToObjectSpace(PointToWorldSpace(p)) = %+v, want %+v", back, p)
+	}
+
+	identity := cf.Mul(cf.Inverse())
+	for i, f := range identity.R {
+		want := float32(0)
+		if i%4 == 0 {
+			want = 1
+		}
+		if math.Abs(float64(f-want)) > 1e-4 {
+			t.Errorf("cf.Mul(cf.Inverse()).R[%d] = %v, want %v", i, f, want)
+		}
+	}
+}
+
+func TestEulerAnglesRoundTrip(t *testing.T) {
+	x, y, z := float32(0.3), float32(-0.6), float32(1.1)
+	cf := FromEulerAnglesXYZ(x, y, z)
+	gx, gy, gz := cf.ToEulerAnglesXYZ()
+
+	if math.Abs(float64(gx-x)) > 1e-4 || math.Abs(float64(gy-y)) > 1e-4 || math.Abs(float64(gz-z)) > 1e-4 {
+		t.Errorf("ToEulerAnglesXYZ() = (%v, %v, %v), want (%v, %v, %v)", gx, gy, gz, x, y, z)
+	}
+}
+
+func TestRayClosestPoint(t *testing.T) {
+	ray := Ray{Origin: Vector3{X: 0}, Direction: Vector3{X: 1}}
+	if got := ray.ClosestPoint(Vector3{X: 5, Y: 3}); got != (Vector3{X: 5}) {
+		t.Errorf("ClosestPoint = %+v, want {5 0 0}", got)
+	}
+	if got := ray.ClosestPoint(Vector3{X: -5}); got != (Vector3{}) {
+		t.Errorf("ClosestPoint behind origin = %+v, want origin", got)
+	}
+}
+
+func TestRegion3Contains(t *testing.T) {
+	r := Region3{Size: Vector3{X: 2, Y: 2, Z: 2}}
+	if !r.Contains(Vector3{}) {
+		t.Error("Contains(center) = false, want true")
+	}
+	if r.Contains(Vector3{X: 2}) {
+		t.Error("Contains(outside) = true, want false")
+	}
+}
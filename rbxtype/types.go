@@ -4,7 +4,6 @@ package rbxtype
 
 import (
 	"errors"
-	"github.com/robloxapi/rbxfile"
 	"strconv"
 	"strings"
 )
@@ -309,26 +308,26 @@ func (t BrickColor) String() string {
 
 //
 func (bc BrickColor) Name() string {
-	name, ok := brickColorNames[bc]
+	name, ok := nameByID[bc]
 	if !ok {
-		return brickColorNames[194]
+		return nameByID[194]
 	}
 
 	return name
 }
 
 func (bc BrickColor) Color() Color3 {
-	color, ok := brickColorColors[bc]
+	color, ok := colorByID[bc]
 	if !ok {
-		return brickColorColors[194]
+		return colorByID[194]
 	}
 
 	return color
 }
 
 func (bc BrickColor) Palette() int {
-	for i, n := range brickColorPalette {
-		if bc == n {
+	for i, entry := range palette {
+		if bc == entry.ID {
 			return i
 		}
 	}
@@ -442,13 +441,22 @@ func (t Token) Copy() Type {
 
 ////////////////
 
-type Reference *rbxfile.Instance
+// Reference holds a link to another Instance in the same tree. The
+// pointer is wrapped in a struct rather than named directly (as in
+// `type Reference *Instance`) because Go does not allow methods on a
+// named type whose underlying type is itself a pointer.
+type Reference struct {
+	Instance *Instance
+}
 
 func (Reference) TypeString() string {
 	return "Ref"
 }
 func (t Reference) String() string {
-	return *rbxfile.Instance(t).Name()
+	if t.Instance == nil {
+		return "nil"
+	}
+	return t.Instance.Name()
 }
 func (t Reference) Copy() Type {
 	return t
@@ -0,0 +1,493 @@
+package rbxxml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Gskartwii/rbxfile/rbxtype"
+)
+
+// A Decoder reads a Roblox XML place/model document from an input stream.
+type Decoder struct {
+	d *xml.Decoder
+
+	// referents maps an Item's referent attribute to the instance it
+	// names, populated as each Item is decoded.
+	referents map[string]*rbxtype.Instance
+
+	// pending holds every non-null Ref property seen so far. Since a
+	// target referent may be declared after the Ref that names it, they
+	// are all resolved in a second pass once the whole tree is known.
+	pending []pendingRef
+}
+
+type pendingRef struct {
+	inst     *rbxtype.Instance
+	prop     string
+	referent string
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		d:         xml.NewDecoder(r),
+		referents: map[string]*rbxtype.Instance{},
+	}
+}
+
+// Decode reads a complete place/model document and resolves every Ref
+// property against the referents declared within it.
+func (dec *Decoder) Decode() (*rbxtype.Root, error) {
+	root := &rbxtype.Root{}
+
+	if err := dec.expectRoot(); err != nil {
+		return nil, err
+	}
+
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local != itemTag {
+				if err := skipElement(dec.d); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			inst, err := dec.decodeInstance(t)
+			if err != nil {
+				return nil, err
+			}
+			root.Instances = append(root.Instances, inst)
+		case xml.EndElement:
+			if t.Name.Local == rootTag {
+				if err := dec.resolveReferences(); err != nil {
+					return nil, err
+				}
+				return root, nil
+			}
+		}
+	}
+}
+
+func (dec *Decoder) expectRoot() error {
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if start.Name.Local != rootTag {
+				return fmt.Errorf("rbxxml: expected <%s>, got <%s>", rootTag, start.Name.Local)
+			}
+			return nil
+		}
+	}
+}
+
+func (dec *Decoder) decodeInstance(start xml.StartElement) (*rbxtype.Instance, error) {
+	inst := &rbxtype.Instance{
+		Properties: map[string]rbxtype.Type{},
+	}
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "class":
+			inst.ClassName = attr.Value
+		case "referent":
+			inst.Reference = attr.Value
+		case "service":
+			inst.IsService = attr.Value == "true"
+		}
+	}
+	if inst.Reference != "" {
+		dec.referents[inst.Reference] = inst
+	}
+
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case propertiesTag:
+				if err := dec.decodeProperties(inst); err != nil {
+					return nil, err
+				}
+			case itemTag:
+				child, err := dec.decodeInstance(t)
+				if err != nil {
+					return nil, err
+				}
+				inst.Children = append(inst.Children, child)
+			default:
+				if err := skipElement(dec.d); err != nil {
+					return nil, err
+				}
+			}
+		case xml.EndElement:
+			if t.Name.Local == itemTag {
+				return inst, nil
+			}
+		}
+	}
+}
+
+func (dec *Decoder) decodeProperties(inst *rbxtype.Instance) error {
+	for {
+		tok, err := dec.d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if !knownTags[t.Name.Local] {
+				if err := skipElement(dec.d); err != nil {
+					return err
+				}
+				continue
+			}
+			name := attrValue(t, "name")
+			if t.Name.Local == "Ref" {
+				text, err := readText(dec.d, t.Name)
+				if err != nil {
+					return err
+				}
+				dec.deferReference(inst, name, strings.TrimSpace(text))
+				continue
+			}
+			value, err := dec.decodeValue(t)
+			if err != nil {
+				return err
+			}
+			inst.Properties[name] = value
+		case xml.EndElement:
+			if t.Name.Local == propertiesTag {
+				return nil
+			}
+		}
+	}
+}
+
+func (dec *Decoder) deferReference(inst *rbxtype.Instance, prop, referent string) {
+	if referent == "" || referent == "null" {
+		inst.Properties[prop] = rbxtype.Reference{}
+		return
+	}
+	dec.pending = append(dec.pending, pendingRef{inst: inst, prop: prop, referent: referent})
+}
+
+func (dec *Decoder) resolveReferences() error {
+	for _, p := range dec.pending {
+		target, ok := dec.referents[p.referent]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownReferent, p.referent)
+		}
+		p.inst.Properties[p.prop] = rbxtype.Reference{Instance: target}
+	}
+	return nil
+}
+
+// decodeValue decodes the content of a single property element. start has
+// already been consumed; the caller has verified its tag is known and is
+// not "Ref".
+func (dec *Decoder) decodeValue(start xml.StartElement) (rbxtype.Type, error) {
+	d := dec.d
+	switch start.Name.Local {
+	case "string":
+		text, err := readText(d, start.Name)
+		return rbxtype.String(text), err
+	case "BinaryString":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		b, err := decodeBase64(text)
+		return rbxtype.BinaryString(b), err
+	case "ProtectedString":
+		text, err := readText(d, start.Name)
+		return rbxtype.ProtectedString(text), err
+	case "Content":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return rbxtype.Content(fields["url"]), nil
+	case "bool":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		return rbxtype.Bool(strings.TrimSpace(text) == "true"), nil
+	case "int":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		i, err := atoi(strings.TrimSpace(text))
+		return rbxtype.Int(i), err
+	case "float":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parseFloat32(strings.TrimSpace(text))
+		return rbxtype.Float(f), err
+	case "double":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		return rbxtype.Double(f), err
+	case "UDim":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		scale, err := parseFloat32(fields["S"])
+		if err != nil {
+			return nil, err
+		}
+		offset, err := atoi(fields["O"])
+		return rbxtype.UDim{Scale: scale, Offset: offset}, err
+	case "UDim2":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeUDim2(fields)
+	case "Ray":
+		return dec.decodeRay()
+	case "Faces":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		i, err := atoi(strings.TrimSpace(text))
+		return unmaskFaces(i), err
+	case "Axes":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		i, err := atoi(strings.TrimSpace(text))
+		return unmaskAxes(i), err
+	case "BrickColor":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		i, err := strconv.ParseUint(strings.TrimSpace(text), 10, 32)
+		return rbxtype.BrickColor(i), err
+	case "Color3":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeColor3(fields)
+	case "Vector2":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeVector2(fields)
+	case "Vector3":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeVector3(fields)
+	case "CoordinateFrame":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeCFrame(fields)
+	case "token":
+		text, err := readText(d, start.Name)
+		if err != nil {
+			return nil, err
+		}
+		i, err := atoi(strings.TrimSpace(text))
+		return rbxtype.Token(i), err
+	case "Vector3int16":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeVector3int16(fields)
+	case "Vector2int16":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeVector2int16(fields)
+	case "Region3":
+		return dec.decodeRegion3()
+	case "Region3int16":
+		fields, err := readFields(d)
+		if err != nil {
+			return nil, err
+		}
+		return decodeRegion3int16(fields)
+	default:
+		return nil, fmt.Errorf("rbxxml: unsupported property type %q", start.Name.Local)
+	}
+}
+
+func (dec *Decoder) decodeRay() (rbxtype.Ray, error) {
+	d := dec.d
+	var ray rbxtype.Ray
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return ray, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			fields, err := readFields(d)
+			if err != nil {
+				return ray, err
+			}
+			v, err := decodeVector3(fields)
+			if err != nil {
+				return ray, err
+			}
+			switch t.Name.Local {
+			case "origin":
+				ray.Origin = v
+			case "direction":
+				ray.Direction = v
+			}
+		case xml.EndElement:
+			return ray, nil
+		}
+	}
+}
+
+func (dec *Decoder) decodeRegion3() (rbxtype.Region3, error) {
+	d := dec.d
+	var region rbxtype.Region3
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return region, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "CoordinateFrame":
+				fields, err := readFields(d)
+				if err != nil {
+					return region, err
+				}
+				cf, err := decodeCFrame(fields)
+				if err != nil {
+					return region, err
+				}
+				region.CFrame = cf
+			case "Size":
+				fields, err := readFields(d)
+				if err != nil {
+					return region, err
+				}
+				v, err := decodeVector3(fields)
+				if err != nil {
+					return region, err
+				}
+				region.Size = v
+			default:
+				if err := skipElement(d); err != nil {
+					return region, err
+				}
+			}
+		case xml.EndElement:
+			return region, nil
+		}
+	}
+}
+
+func attrValue(start xml.StartElement, local string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// readText returns the character data of the element named name, whose
+// StartElement has already been consumed. Unknown nested elements are
+// skipped rather than erroring, since they carry no data this package
+// understands.
+func readText(d *xml.Decoder, name xml.Name) (string, error) {
+	var b strings.Builder
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			b.Write(t)
+		case xml.EndElement:
+			if t.Name == name {
+				return b.String(), nil
+			}
+		case xml.StartElement:
+			if err := skipElement(d); err != nil {
+				return "", err
+			}
+		}
+	}
+}
+
+// readFields reads a run of flat child elements (tag plus character data)
+// until the end of their enclosing element, which has already been
+// consumed up to its StartElement.
+func readFields(d *xml.Decoder) (map[string]string, error) {
+	fields := map[string]string{}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			text, err := readText(d, t.Name)
+			if err != nil {
+				return nil, err
+			}
+			fields[t.Name.Local] = text
+		case xml.EndElement:
+			return fields, nil
+		}
+	}
+}
+
+// skipElement discards the remainder of the element whose StartElement has
+// just been consumed.
+func skipElement(d *xml.Decoder) error {
+	depth := 1
+	for depth > 0 {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
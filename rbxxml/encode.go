@@ -0,0 +1,217 @@
+package rbxxml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/Gskartwii/rbxfile/rbxtype"
+)
+
+// An Encoder writes a rbxtype.Root to an output stream in Roblox's XML
+// place/model format.
+type Encoder struct {
+	w    *xml.Encoder
+	refs map[*rbxtype.Instance]string
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{
+		w:    xml.NewEncoder(w),
+		refs: map[*rbxtype.Instance]string{},
+	}
+}
+
+// Encode writes root to the Encoder's stream as a complete document.
+func (e *Encoder) Encode(root *rbxtype.Root) error {
+	start := xml.StartElement{Name: xml.Name{Local: rootTag}, Attr: []xml.Attr{
+		{Name: xml.Name{Local: "version"}, Value: "4"},
+	}}
+	if err := e.w.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, inst := range root.Instances {
+		if err := e.encodeInstance(inst); err != nil {
+			return err
+		}
+	}
+	if err := e.w.EncodeToken(start.End()); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
+
+func (e *Encoder) encodeInstance(inst *rbxtype.Instance) error {
+	start := xml.StartElement{Name: xml.Name{Local: itemTag}, Attr: []xml.Attr{
+		{Name: xml.Name{Local: "class"}, Value: inst.ClassName},
+		{Name: xml.Name{Local: "referent"}, Value: referent(inst, e.refs)},
+	}}
+	if inst.IsService {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "service"}, Value: "true"})
+	}
+	if err := e.w.EncodeToken(start); err != nil {
+		return err
+	}
+
+	props := xml.StartElement{Name: xml.Name{Local: propertiesTag}}
+	if err := e.w.EncodeToken(props); err != nil {
+		return err
+	}
+	for name, value := range inst.Properties {
+		if err := e.encodeProperty(name, value); err != nil {
+			return err
+		}
+	}
+	if err := e.w.EncodeToken(props.End()); err != nil {
+		return err
+	}
+
+	for _, child := range inst.Children {
+		if err := e.encodeInstance(child); err != nil {
+			return err
+		}
+	}
+
+	return e.w.EncodeToken(start.End())
+}
+
+func (e *Encoder) encodeProperty(name string, value rbxtype.Type) error {
+	tag := value.TypeString()
+	if !knownTags[tag] {
+		return fmt.Errorf("rbxxml: cannot encode property %q of type %q", name, tag)
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: tag}, Attr: []xml.Attr{
+		{Name: xml.Name{Local: "name"}, Value: name},
+	}}
+	if err := e.w.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := e.encodeValue(value); err != nil {
+		return err
+	}
+	return e.w.EncodeToken(start.End())
+}
+
+// encodeValue writes the content of a property element: either character
+// data directly under the tag, or a handful of child elements for
+// aggregate types.
+func (e *Encoder) encodeValue(value rbxtype.Type) error {
+	switch v := value.(type) {
+	case rbxtype.String:
+		return e.chardata(string(v))
+	case rbxtype.BinaryString:
+		return e.chardata(encodeBase64([]byte(v)))
+	case rbxtype.ProtectedString:
+		return e.chardata(string(v))
+	case rbxtype.Content:
+		return e.element("url", string(v))
+	case rbxtype.Bool:
+		return e.chardata(v.String())
+	case rbxtype.Int:
+		return e.chardata(v.String())
+	case rbxtype.Float:
+		return e.chardata(formatFloat32(float32(v)))
+	case rbxtype.Double:
+		return e.chardata(formatFloat64(float64(v)))
+	case rbxtype.UDim:
+		return e.elements("S", formatFloat32(v.Scale), "O", itoa(v.Offset))
+	case rbxtype.UDim2:
+		return e.elements(
+			"XS", formatFloat32(v.X.Scale), "XO", itoa(v.X.Offset),
+			"YS", formatFloat32(v.Y.Scale), "YO", itoa(v.Y.Offset),
+		)
+	case rbxtype.Ray:
+		if err := e.wrap("origin", func() error { return e.vector3(v.Origin) }); err != nil {
+			return err
+		}
+		return e.wrap("direction", func() error { return e.vector3(v.Direction) })
+	case rbxtype.Faces:
+		return e.chardata(itoa(facesMask(v)))
+	case rbxtype.Axes:
+		return e.chardata(itoa(axesMask(v)))
+	case rbxtype.BrickColor:
+		return e.chardata(v.String())
+	case rbxtype.Color3:
+		return e.elements("R", formatFloat32(v.R), "G", formatFloat32(v.G), "B", formatFloat32(v.B))
+	case rbxtype.Vector2:
+		return e.elements("X", formatFloat32(v.X), "Y", formatFloat32(v.Y))
+	case rbxtype.Vector3:
+		return e.vector3(v)
+	case rbxtype.CFrame:
+		if err := e.elements("X", formatFloat32(v.X), "Y", formatFloat32(v.Y), "Z", formatFloat32(v.Z)); err != nil {
+			return err
+		}
+		for i, f := range v.R {
+			if err := e.element(fmt.Sprintf("R%02d", i), formatFloat32(f)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case rbxtype.Token:
+		return e.chardata(v.String())
+	case rbxtype.Reference:
+		if v.Instance == nil {
+			return e.chardata("null")
+		}
+		return e.chardata(referent(v.Instance, e.refs))
+	case rbxtype.Vector3int16:
+		return e.elements("X", itoa16(v.X), "Y", itoa16(v.Y), "Z", itoa16(v.Z))
+	case rbxtype.Vector2int16:
+		return e.elements("X", itoa16(v.X), "Y", itoa16(v.Y))
+	case rbxtype.Region3:
+		if err := e.wrap("CoordinateFrame", func() error { return e.encodeValue(v.CFrame) }); err != nil {
+			return err
+		}
+		return e.wrap("Size", func() error { return e.vector3(v.Size) })
+	case rbxtype.Region3int16:
+		return e.elements(
+			"MinX", itoa16(v.Min.X), "MinY", itoa16(v.Min.Y), "MinZ", itoa16(v.Min.Z),
+			"MaxX", itoa16(v.Max.X), "MaxY", itoa16(v.Max.Y), "MaxZ", itoa16(v.Max.Z),
+		)
+	default:
+		return fmt.Errorf("rbxxml: unsupported value type %T", value)
+	}
+}
+
+func (e *Encoder) vector3(v rbxtype.Vector3) error {
+	return e.elements("X", formatFloat32(v.X), "Y", formatFloat32(v.Y), "Z", formatFloat32(v.Z))
+}
+
+func (e *Encoder) chardata(s string) error {
+	return e.w.EncodeToken(xml.CharData(s))
+}
+
+func (e *Encoder) element(tag, value string) error {
+	start := xml.StartElement{Name: xml.Name{Local: tag}}
+	if err := e.w.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := e.chardata(value); err != nil {
+		return err
+	}
+	return e.w.EncodeToken(start.End())
+}
+
+// elements writes a run of tag/value pairs as sibling elements.
+func (e *Encoder) elements(pairs ...string) error {
+	for i := 0; i < len(pairs); i += 2 {
+		if err := e.element(pairs[i], pairs[i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wrap writes tag around whatever body writes.
+func (e *Encoder) wrap(tag string, body func() error) error {
+	start := xml.StartElement{Name: xml.Name{Local: tag}}
+	if err := e.w.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := body(); err != nil {
+		return err
+	}
+	return e.w.EncodeToken(start.End())
+}
@@ -0,0 +1,252 @@
+package rbxxml
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Gskartwii/rbxfile/rbxtype"
+)
+
+func parseFloat32(s string) (float32, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 32)
+	return float32(f), err
+}
+
+func itoa(i int32) string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func itoa16(i int16) string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func atoi(s string) (int32, error) {
+	i, err := strconv.ParseInt(s, 10, 32)
+	return int32(i), err
+}
+
+func atoi16(s string) (int16, error) {
+	i, err := strconv.ParseInt(s, 10, 16)
+	return int16(i), err
+}
+
+// facesMask and axesMask encode the Faces/Axes bitsets the way Roblox's
+// engine does: one bit per face/axis, ordered Right, Top, Back, Left,
+// Bottom, Front and X, Y, Z.
+func facesMask(f rbxtype.Faces) int32 {
+	var m int32
+	if f.Right {
+		m |= 1 << 0
+	}
+	if f.Top {
+		m |= 1 << 1
+	}
+	if f.Back {
+		m |= 1 << 2
+	}
+	if f.Left {
+		m |= 1 << 3
+	}
+	if f.Bottom {
+		m |= 1 << 4
+	}
+	if f.Front {
+		m |= 1 << 5
+	}
+	return m
+}
+
+func unmaskFaces(m int32) rbxtype.Faces {
+	return rbxtype.Faces{
+		Right:  m&(1<<0) != 0,
+		Top:    m&(1<<1) != 0,
+		Back:   m&(1<<2) != 0,
+		Left:   m&(1<<3) != 0,
+		Bottom: m&(1<<4) != 0,
+		Front:  m&(1<<5) != 0,
+	}
+}
+
+func axesMask(a rbxtype.Axes) int32 {
+	var m int32
+	if a.X {
+		m |= 1 << 0
+	}
+	if a.Y {
+		m |= 1 << 1
+	}
+	if a.Z {
+		m |= 1 << 2
+	}
+	return m
+}
+
+func unmaskAxes(m int32) rbxtype.Axes {
+	return rbxtype.Axes{
+		X: m&(1<<0) != 0,
+		Y: m&(1<<1) != 0,
+		Z: m&(1<<2) != 0,
+	}
+}
+
+func decodeUDim2(f map[string]string) (rbxtype.UDim2, error) {
+	xs, err := parseFloat32(f["XS"])
+	if err != nil {
+		return rbxtype.UDim2{}, err
+	}
+	xo, err := atoi(f["XO"])
+	if err != nil {
+		return rbxtype.UDim2{}, err
+	}
+	ys, err := parseFloat32(f["YS"])
+	if err != nil {
+		return rbxtype.UDim2{}, err
+	}
+	yo, err := atoi(f["YO"])
+	if err != nil {
+		return rbxtype.UDim2{}, err
+	}
+	return rbxtype.UDim2{
+		X: rbxtype.UDim{Scale: xs, Offset: xo},
+		Y: rbxtype.UDim{Scale: ys, Offset: yo},
+	}, nil
+}
+
+func decodeColor3(f map[string]string) (rbxtype.Color3, error) {
+	r, err := parseFloat32(f["R"])
+	if err != nil {
+		return rbxtype.Color3{}, err
+	}
+	g, err := parseFloat32(f["G"])
+	if err != nil {
+		return rbxtype.Color3{}, err
+	}
+	b, err := parseFloat32(f["B"])
+	if err != nil {
+		return rbxtype.Color3{}, err
+	}
+	return rbxtype.Color3{R: r, G: g, B: b}, nil
+}
+
+func decodeVector2(f map[string]string) (rbxtype.Vector2, error) {
+	x, err := parseFloat32(f["X"])
+	if err != nil {
+		return rbxtype.Vector2{}, err
+	}
+	y, err := parseFloat32(f["Y"])
+	if err != nil {
+		return rbxtype.Vector2{}, err
+	}
+	return rbxtype.Vector2{X: x, Y: y}, nil
+}
+
+func decodeVector3(f map[string]string) (rbxtype.Vector3, error) {
+	x, err := parseFloat32(f["X"])
+	if err != nil {
+		return rbxtype.Vector3{}, err
+	}
+	y, err := parseFloat32(f["Y"])
+	if err != nil {
+		return rbxtype.Vector3{}, err
+	}
+	z, err := parseFloat32(f["Z"])
+	if err != nil {
+		return rbxtype.Vector3{}, err
+	}
+	return rbxtype.Vector3{X: x, Y: y, Z: z}, nil
+}
+
+func decodeVector3int16(f map[string]string) (rbxtype.Vector3int16, error) {
+	x, err := atoi16(f["X"])
+	if err != nil {
+		return rbxtype.Vector3int16{}, err
+	}
+	y, err := atoi16(f["Y"])
+	if err != nil {
+		return rbxtype.Vector3int16{}, err
+	}
+	z, err := atoi16(f["Z"])
+	if err != nil {
+		return rbxtype.Vector3int16{}, err
+	}
+	return rbxtype.Vector3int16{X: x, Y: y, Z: z}, nil
+}
+
+func decodeVector2int16(f map[string]string) (rbxtype.Vector2int16, error) {
+	x, err := atoi16(f["X"])
+	if err != nil {
+		return rbxtype.Vector2int16{}, err
+	}
+	y, err := atoi16(f["Y"])
+	if err != nil {
+		return rbxtype.Vector2int16{}, err
+	}
+	return rbxtype.Vector2int16{X: x, Y: y}, nil
+}
+
+func decodeRegion3int16(f map[string]string) (rbxtype.Region3int16, error) {
+	minX, err := atoi16(f["MinX"])
+	if err != nil {
+		return rbxtype.Region3int16{}, err
+	}
+	minY, err := atoi16(f["MinY"])
+	if err != nil {
+		return rbxtype.Region3int16{}, err
+	}
+	minZ, err := atoi16(f["MinZ"])
+	if err != nil {
+		return rbxtype.Region3int16{}, err
+	}
+	maxX, err := atoi16(f["MaxX"])
+	if err != nil {
+		return rbxtype.Region3int16{}, err
+	}
+	maxY, err := atoi16(f["MaxY"])
+	if err != nil {
+		return rbxtype.Region3int16{}, err
+	}
+	maxZ, err := atoi16(f["MaxZ"])
+	if err != nil {
+		return rbxtype.Region3int16{}, err
+	}
+	return rbxtype.Region3int16{
+		Min: rbxtype.Vector3int16{X: minX, Y: minY, Z: minZ},
+		Max: rbxtype.Vector3int16{X: maxX, Y: maxY, Z: maxZ},
+	}, nil
+}
+
+func decodeCFrame(f map[string]string) (rbxtype.CFrame, error) {
+	x, err := parseFloat32(f["X"])
+	if err != nil {
+		return rbxtype.CFrame{}, err
+	}
+	y, err := parseFloat32(f["Y"])
+	if err != nil {
+		return rbxtype.CFrame{}, err
+	}
+	z, err := parseFloat32(f["Z"])
+	if err != nil {
+		return rbxtype.CFrame{}, err
+	}
+	cf := rbxtype.CFrame{X: x, Y: y, Z: z}
+	for i := range cf.R {
+		v, err := parseFloat32(f[rName(i)])
+		if err != nil {
+			return rbxtype.CFrame{}, err
+		}
+		cf.R[i] = v
+	}
+	return cf, nil
+}
+
+func rName(i int) string {
+	return "R" + pad2(i)
+}
+
+func pad2(i int) string {
+	s := strconv.Itoa(i)
+	if len(s) < 2 {
+		return "0" + s
+	}
+	return s
+}
@@ -0,0 +1,74 @@
+// Package rbxxml reads and writes Roblox's XML place and model format
+// (.rbxmx/.rbxlx), mapping between the file's <Properties> elements and the
+// in-memory values of package rbxtype.
+package rbxxml
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+
+	"github.com/Gskartwii/rbxfile/rbxtype"
+)
+
+// ErrUnknownReferent is returned when a Ref property refers to a referent
+// string that was never declared by an Item in the file.
+var ErrUnknownReferent = errors.New("rbxxml: unknown referent")
+
+// rootTag and itemTag are the element names of the document root and of
+// each instance within it.
+const (
+	rootTag       = "roblox"
+	itemTag       = "Item"
+	propertiesTag = "Properties"
+)
+
+// propertyTags lists the XML element names used to hold a value inside a
+// <Properties> element. Every tag is identical to the rbxtype.Type's
+// TypeString, so the slice doubles as the set of types this package knows
+// how to encode and decode.
+var propertyTags = []string{
+	"string", "BinaryString", "ProtectedString", "Content",
+	"bool", "int", "float", "double",
+	"UDim", "UDim2", "Ray", "Faces", "Axes",
+	"BrickColor", "Color3", "Vector2", "Vector3", "CoordinateFrame",
+	"token", "Ref", "Vector3int16", "Vector2int16", "Region3", "Region3int16",
+}
+
+// knownTags is propertyTags as a set, for quick membership tests while
+// decoding.
+var knownTags = func() map[string]bool {
+	m := make(map[string]bool, len(propertyTags))
+	for _, tag := range propertyTags {
+		m[tag] = true
+	}
+	return m
+}()
+
+func encodeBase64(b []byte) string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(s)
+}
+
+func formatFloat32(f float32) string {
+	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+}
+
+func formatFloat64(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// referent returns the Item referent string recorded for inst in refs,
+// generating one from its position in the table if refs does not already
+// hold one. inst.Reference is not consulted.
+func referent(inst *rbxtype.Instance, refs map[*rbxtype.Instance]string) string {
+	if ref, ok := refs[inst]; ok {
+		return ref
+	}
+	ref := "RBX" + strconv.Itoa(len(refs))
+	refs[inst] = ref
+	return ref
+}
@@ -0,0 +1,220 @@
+package rbxxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/Gskartwii/rbxfile/rbxtype"
+)
+
+// partProperties covers every wired type other than Reference, which is
+// exercised separately via the Parent property on the Workspace instance.
+func partProperties() map[string]rbxtype.Type {
+	return map[string]rbxtype.Type{
+		"Name":            rbxtype.String("Part"),
+		"Tag":             rbxtype.ProtectedString("print(\"hi\")"),
+		"TextureID":       rbxtype.Content("rbxassetid://1234"),
+		"Anchored":        rbxtype.Bool(true),
+		"BrickColor":      rbxtype.BrickColor(194),
+		"Color":           rbxtype.Color3{R: 1, G: 0.5, B: 0},
+		"Data":            rbxtype.BinaryString([]byte{0, 1, 2, 3, 0xFF}),
+		"Transparency":    rbxtype.Float(0.25),
+		"Mass":            rbxtype.Double(12.5),
+		"Priority":        rbxtype.Int(-7),
+		"SurfaceType":     rbxtype.Token(3),
+		"Size":            rbxtype.Vector3{X: 4, Y: 1.2, Z: -8},
+		"CFrame":          rbxtype.CFrame{X: 1, Y: 2, Z: 3, R: [9]float32{1, 0, 0, 0, 1, 0, 0, 0, 1}},
+		"RelativeSize":    rbxtype.Vector2{X: 0.5, Y: 0.75},
+		"GridSize":        rbxtype.Vector3int16{X: 1, Y: -2, Z: 3},
+		"GridOffset":      rbxtype.Vector2int16{X: -1, Y: 2},
+		"SizeConstraint":  rbxtype.UDim{Scale: 0.5, Offset: 10},
+		"Position2D":      rbxtype.UDim2{X: rbxtype.UDim{Scale: 0.5, Offset: 10}, Y: rbxtype.UDim{Scale: 1, Offset: -5}},
+		"MouseRay":        rbxtype.Ray{Origin: Vector3_(1, 2, 3), Direction: Vector3_(0, -1, 0)},
+		"TopSurface":      rbxtype.Faces{Top: true, Front: true},
+		"DragAxes":        rbxtype.Axes{X: true, Z: true},
+		"SpawnRegion":     rbxtype.Region3{CFrame: rbxtype.CFrame{R: [9]float32{1, 0, 0, 0, 1, 0, 0, 0, 1}}, Size: rbxtype.Vector3{X: 4, Y: 4, Z: 4}},
+		"SpawnRegionGrid": rbxtype.Region3int16{Min: rbxtype.Vector3int16{X: -1}, Max: rbxtype.Vector3int16{X: 1}},
+	}
+}
+
+func Vector3_(x, y, z float32) rbxtype.Vector3 {
+	return rbxtype.Vector3{X: x, Y: y, Z: z}
+}
+
+func sampleRoot() *rbxtype.Root {
+	child := &rbxtype.Instance{
+		ClassName:  "Part",
+		Reference:  "part1",
+		Properties: partProperties(),
+	}
+	root := &rbxtype.Instance{
+		ClassName: "Workspace",
+		Reference: "root",
+		IsService: true,
+		Properties: map[string]rbxtype.Type{
+			"Parent": rbxtype.Reference{Instance: child},
+		},
+		Children: []*rbxtype.Instance{child},
+	}
+	return &rbxtype.Root{Instances: []*rbxtype.Instance{root}}
+}
+
+func roundTrip(t *testing.T, root *rbxtype.Root) *rbxtype.Root {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(root); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	got, err := NewDecoder(&buf).Decode()
+	if err != nil {
+		t.Fatalf("Decode: %v\n%s", err, buf.String())
+	}
+	return got
+}
+
+func TestRoundTrip(t *testing.T) {
+	root := sampleRoot()
+	got := roundTrip(t, root)
+
+	if len(got.Instances) != 1 {
+		t.Fatalf("got %d top-level instances, want 1", len(got.Instances))
+	}
+	ws := got.Instances[0]
+	if ws.ClassName != "Workspace" {
+		t.Errorf("ClassName = %q, want Workspace", ws.ClassName)
+	}
+	if len(ws.Children) != 1 {
+		t.Fatalf("got %d children, want 1", len(ws.Children))
+	}
+	part := ws.Children[0]
+	if part.ClassName != "Part" {
+		t.Errorf("ClassName = %q, want Part", part.ClassName)
+	}
+
+	want := partProperties()
+	for name, wantValue := range want {
+		gotValue, ok := part.Properties[name]
+		if !ok {
+			t.Errorf("property %q missing after round trip", name)
+			continue
+		}
+		if !rbxtype.Equal(gotValue, wantValue) {
+			t.Errorf("property %q = %#v, want %#v", name, gotValue, wantValue)
+		}
+	}
+
+	parent, ok := ws.Properties["Parent"].(rbxtype.Reference)
+	if !ok {
+		t.Fatalf("Parent property is %T, want Reference", ws.Properties["Parent"])
+	}
+	if parent.Instance != part {
+		t.Errorf("Parent reference did not resolve to the decoded Part instance")
+	}
+
+	if !ws.IsService {
+		t.Error("Workspace instance should round-trip IsService = true")
+	}
+	if part.IsService {
+		t.Error("Part instance should round-trip IsService = false")
+	}
+}
+
+func TestDecodeUnknownReferent(t *testing.T) {
+	const doc = `<roblox version="4">` +
+		`<Item class="Part" referent="part1">` +
+		`<Properties><Ref name="Parent">part-does-not-exist</Ref></Properties>` +
+		`</Item>` +
+		`</roblox>`
+
+	_, err := NewDecoder(strings.NewReader(doc)).Decode()
+	if !errors.Is(err, ErrUnknownReferent) {
+		t.Fatalf("Decode() error = %v, want ErrUnknownReferent", err)
+	}
+}
+
+// TestDecodeValueUnsupportedType exercises decodeValue's default case
+// directly: decodeProperties only ever calls it with a tag already present
+// in knownTags, so the "unsupported property type" error can't be reached
+// through the public Decode API.
+func TestDecodeValueUnsupportedType(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(``))
+	start := xml.StartElement{Name: xml.Name{Local: "bogus"}}
+	_, err := dec.decodeValue(start)
+	if err == nil {
+		t.Fatal("decodeValue with an unregistered tag should return an error")
+	}
+	if !strings.Contains(err.Error(), "unsupported property type") {
+		t.Errorf("decodeValue() error = %v, want an \"unsupported property type\" error", err)
+	}
+}
+
+func TestEncodeUnsupportedType(t *testing.T) {
+	inst := &rbxtype.Instance{
+		ClassName: "Part",
+		Reference: "part1",
+		Properties: map[string]rbxtype.Type{
+			"Bogus": bogusType{},
+		},
+	}
+	root := &rbxtype.Root{Instances: []*rbxtype.Instance{inst}}
+
+	var buf bytes.Buffer
+	err := NewEncoder(&buf).Encode(root)
+	if err == nil {
+		t.Fatal("Encode of an unregistered property type should fail")
+	}
+	if !strings.Contains(err.Error(), "cannot encode property") {
+		t.Errorf("Encode() error = %v, want a \"cannot encode property\" error", err)
+	}
+}
+
+// bogusType implements rbxtype.Type with a TypeString that rbxxml does not
+// recognize, to exercise encodeProperty's unknown-tag error path.
+type bogusType struct{}
+
+func (bogusType) TypeString() string { return "Bogus" }
+func (bogusType) String() string     { return "" }
+func (bogusType) Copy() rbxtype.Type { return bogusType{} }
+
+// FuzzRoundTrip checks that encoding and then decoding a Part with an
+// arbitrary name, size, anchored flag, and brick color never errors and
+// preserves the name, Size.X, anchored flag, and brick color exactly.
+func FuzzRoundTrip(f *testing.F) {
+	f.Add("Baseplate", float32(512), float32(1.2), float32(-512), true, uint32(194))
+	f.Add("", float32(0), float32(0), float32(0), false, uint32(1))
+	f.Add("\"quoted\"\n\ttabbed", float32(math.Inf(1)), float32(math.NaN()), float32(-0.0), true, uint32(21))
+
+	f.Fuzz(func(t *testing.T, name string, x, y, z float32, anchored bool, brickColor uint32) {
+		part := &rbxtype.Instance{
+			ClassName: "Part",
+			Reference: "part1",
+			Properties: map[string]rbxtype.Type{
+				"Name":       rbxtype.String(name),
+				"Size":       rbxtype.Vector3{X: x, Y: y, Z: z},
+				"Anchored":   rbxtype.Bool(anchored),
+				"BrickColor": rbxtype.BrickColor(brickColor),
+			},
+		}
+		root := &rbxtype.Root{Instances: []*rbxtype.Instance{part}}
+
+		got := roundTrip(t, root)
+		gotName := string(got.Instances[0].Properties["Name"].(rbxtype.String))
+		if gotName != name {
+			t.Errorf("Name round-tripped to %q, want %q", gotName, name)
+		}
+		gotSize := got.Instances[0].Properties["Size"].(rbxtype.Vector3)
+		if gotSize.X != x && !(math.IsNaN(float64(x)) && math.IsNaN(float64(gotSize.X))) {
+			t.Errorf("Size.X round-tripped to %v, want %v", gotSize.X, x)
+		}
+		if gotAnchored := bool(got.Instances[0].Properties["Anchored"].(rbxtype.Bool)); gotAnchored != anchored {
+			t.Errorf("Anchored round-tripped to %v, want %v", gotAnchored, anchored)
+		}
+		if gotColor := uint32(got.Instances[0].Properties["BrickColor"].(rbxtype.BrickColor)); gotColor != brickColor {
+			t.Errorf("BrickColor round-tripped to %v, want %v", gotColor, brickColor)
+		}
+	})
+}